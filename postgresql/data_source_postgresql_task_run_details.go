@@ -0,0 +1,186 @@
+package postgresql
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// taskRunDetail mirrors a row of cron.job_run_details. job_pid, end_time and
+// return_message are nullable while a run is still in progress.
+type taskRunDetail struct {
+	RunID         int64
+	JobPID        sql.NullInt64
+	StartTime     time.Time
+	EndTime       sql.NullTime
+	Status        string
+	ReturnMessage sql.NullString
+}
+
+func (r taskRunDetail) toMap() map[string]interface{} {
+	var durationSeconds float64
+	endTime := ""
+	if r.EndTime.Valid {
+		endTime = r.EndTime.Time.Format(time.RFC3339)
+		durationSeconds = r.EndTime.Time.Sub(r.StartTime).Seconds()
+	}
+
+	return map[string]interface{}{
+		"runid":            r.RunID,
+		"job_pid":          r.JobPID.Int64,
+		"start_time":       r.StartTime.Format(time.RFC3339),
+		"end_time":         endTime,
+		"status":           r.Status,
+		"return_message":   r.ReturnMessage.String,
+		"duration_seconds": durationSeconds,
+	}
+}
+
+const (
+	taskRunDetailsLimitAttr        = "limit"
+	taskRunDetailsStatusFilterAttr = "status_filter"
+	taskRunDetailsRunsAttr         = "runs"
+)
+
+func dataSourcePostgreSQLTaskRunDetails() *schema.Resource {
+	return &schema.Resource{
+		Read: PGResourceFunc(dataSourcePostgreSQLTaskRunDetailsRead),
+
+		Schema: map[string]*schema.Schema{
+			taskDatabaseAttr: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Used, along with schema, to form the same <db>.<schema>.<name> ID used by postgresql_task.",
+			},
+			taskSchemaAttr: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Used, along with database, to form the same <db>.<schema>.<name> ID used by postgresql_task.",
+			},
+			taskNameAttr: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the task to fetch run history for.",
+			},
+			taskRunDetailsLimitAttr: {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     20,
+				Description: "The maximum number of recent runs to return, most recent first.",
+			},
+			taskRunDetailsStatusFilterAttr: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return runs whose status matches this value (e.g. \"succeeded\", \"failed\").",
+			},
+			taskRunDetailsRunsAttr: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The matching rows from cron.job_run_details, most recent first.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"runid": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The id of the run.",
+						},
+						"job_pid": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The PID of the backend that executed the run.",
+						},
+						"start_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "When the run started.",
+						},
+						"end_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "When the run ended, empty if the run has not finished yet.",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The status of the run, e.g. \"succeeded\" or \"failed\".",
+						},
+						"return_message": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The message returned by the run, such as an error.",
+						},
+						"duration_seconds": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "How long the run took to complete, 0 if it has not finished yet.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePostgreSQLTaskRunDetailsRead(db *DBConnection, d *schema.ResourceData) error {
+	if err := runChecks(db); err != nil {
+		return err
+	}
+
+	taskID, err := genTaskID(db, d)
+	if err != nil {
+		return err
+	}
+
+	limit := d.Get(taskRunDetailsLimitAttr).(int)
+	statusFilter := d.Get(taskRunDetailsStatusFilterAttr).(string)
+
+	query := `SELECT d.runid, d.job_pid, d.start_time, d.end_time, d.status, d.return_message ` +
+		`FROM cron.job_run_details d ` +
+		`JOIN cron.job j ON j.jobid = d.jobid ` +
+		`WHERE j.jobname = $1`
+	args := []interface{}{taskID}
+
+	if statusFilter != "" {
+		query += " AND d.status = $2"
+		args = append(args, statusFilter)
+	}
+
+	query += " ORDER BY d.start_time DESC LIMIT " + fmt.Sprint(limit)
+
+	txn, err := startTransaction(db.client, "")
+	if err != nil {
+		return err
+	}
+	defer deferredRollback(txn)
+
+	rows, err := txn.Query(query, args...)
+	if err != nil {
+		return fmt.Errorf("error reading task run details: %w", err)
+	}
+	defer rows.Close()
+
+	runs := make([]map[string]interface{}, 0)
+	for rows.Next() {
+		var run taskRunDetail
+		if err := rows.Scan(&run.RunID, &run.JobPID, &run.StartTime, &run.EndTime, &run.Status, &run.ReturnMessage); err != nil {
+			return fmt.Errorf("error scanning task run detail: %w", err)
+		}
+		runs = append(runs, run.toMap())
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("error reading task run details: %w", err)
+	}
+
+	if err := txn.Commit(); err != nil {
+		return err
+	}
+
+	d.Set(taskRunDetailsRunsAttr, runs)
+	d.SetId(taskID)
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package postgresql
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccPostgresqlTaskRunDetailsDataSource_Basic(t *testing.T) {
+	skipIfNotAcc(t)
+
+	config := `
+resource "postgresql_extension" "pg_cron" {
+	name = "pg_cron"
+}
+resource "postgresql_task" "basic_task" {
+	name = "basic_task"
+	query = "SELECT * FROM unnest(ARRAY[1]) AS element;"
+	schedule = "* * * * *"
+	depends_on = [postgresql_extension.pg_cron]
+}
+data "postgresql_task_run_details" "basic_task" {
+	name = postgresql_task.basic_task.name
+	limit = 5
+	depends_on = [postgresql_task.basic_task]
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testCheckCompatibleVersion(t, featureTask)
+			testSuperuserPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"data.postgresql_task_run_details.basic_task", "runs.#"),
+				),
+			},
+		},
+	})
+}
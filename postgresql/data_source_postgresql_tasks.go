@@ -0,0 +1,160 @@
+package postgresql
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const tasksListAttr = "tasks"
+
+// dataSourcePostgreSQLTasks lets higher-level modules (retention policies,
+// preheat jobs, etc.) discover the postgresql_task resources they own by
+// vendor_type/vendor_id instead of hard-coding task names.
+func dataSourcePostgreSQLTasks() *schema.Resource {
+	return &schema.Resource{
+		Read: PGResourceFunc(dataSourcePostgreSQLTasksRead),
+
+		Schema: map[string]*schema.Schema{
+			taskVendorTypeAttr: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return tasks tagged with this vendor_type.",
+			},
+			taskVendorIDAttr: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return tasks tagged with this vendor_id.",
+			},
+			tasksListAttr: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The matching tasks.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The postgresql_task id, in <db>.<schema>.<name> form.",
+						},
+						taskDatabaseAttr: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						taskSchemaAttr: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						taskNameAttr: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						taskScheduleAttr: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						taskQueryAttr: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						taskVendorTypeAttr: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						taskVendorIDAttr: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePostgreSQLTasksRead(db *DBConnection, d *schema.ResourceData) error {
+	if err := runChecks(db); err != nil {
+		return err
+	}
+
+	vendorType := d.Get(taskVendorTypeAttr).(string)
+	vendorID := d.Get(taskVendorIDAttr).(string)
+
+	txn, err := startTransaction(db.client, "")
+	if err != nil {
+		return err
+	}
+	defer deferredRollback(txn)
+
+	metaTableExists, err := taskMetaTableExists(txn)
+	if err != nil {
+		return err
+	}
+
+	if !metaTableExists && (vendorType != "" || vendorID != "") {
+		// No task has ever set a vendor attribute, so nothing can match a
+		// vendor filter. Avoid creating taskMetaTable just to query it empty.
+		d.Set(tasksListAttr, []map[string]interface{}{})
+		d.SetId(fmt.Sprintf("%s/%s", vendorType, vendorID))
+		return txn.Commit()
+	}
+
+	query := `SELECT j.jobname, j.schedule, j.command `
+	if metaTableExists {
+		query += `, COALESCE(m.vendor_type, ''), COALESCE(m.vendor_id, '') ` +
+			`FROM cron.job j ` +
+			`LEFT JOIN ` + taskMetaTable + ` m ON m.jobname = j.jobname ` +
+			`WHERE true`
+	} else {
+		query += `, '', '' FROM cron.job j WHERE true`
+	}
+	var args []interface{}
+	if vendorType != "" {
+		args = append(args, vendorType)
+		query += fmt.Sprintf(" AND m.vendor_type = $%d", len(args))
+	}
+	if vendorID != "" {
+		args = append(args, vendorID)
+		query += fmt.Sprintf(" AND m.vendor_id = $%d", len(args))
+	}
+	query += " ORDER BY j.jobname"
+
+	rows, err := txn.Query(query, args...)
+	if err != nil {
+		return fmt.Errorf("error reading tasks: %w", err)
+	}
+	defer rows.Close()
+
+	tasks := make([]map[string]interface{}, 0)
+	for rows.Next() {
+		var jobname, schedule, command, vt, vid string
+		if err := rows.Scan(&jobname, &schedule, &command, &vt, &vid); err != nil {
+			return fmt.Errorf("error scanning task: %w", err)
+		}
+
+		jobnameParts := strings.Split(jobname, ".")
+		tasks = append(tasks, map[string]interface{}{
+			"id":               jobname,
+			taskDatabaseAttr:   jobnameParts[0],
+			taskSchemaAttr:     jobnameParts[1],
+			taskNameAttr:       jobnameParts[2],
+			taskScheduleAttr:   schedule,
+			taskQueryAttr:      command,
+			taskVendorTypeAttr: vt,
+			taskVendorIDAttr:   vid,
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("error reading tasks: %w", err)
+	}
+
+	if err := txn.Commit(); err != nil {
+		return err
+	}
+
+	d.Set(tasksListAttr, tasks)
+	d.SetId(fmt.Sprintf("%s/%s", vendorType, vendorID))
+
+	return nil
+}
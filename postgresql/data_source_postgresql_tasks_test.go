@@ -0,0 +1,59 @@
+package postgresql
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccPostgresqlTasksDataSource_FilterByVendor(t *testing.T) {
+	skipIfNotAcc(t)
+
+	config := `
+resource "postgresql_extension" "pg_cron" {
+	name = "pg_cron"
+}
+resource "postgresql_task" "retention_task" {
+	name = "retention_task"
+	query = "SELECT * FROM unnest(ARRAY[1]) AS element;"
+	schedule = "0 0 * * *"
+	vendor_type = "RETENTION"
+	vendor_id = "orders"
+	depends_on = [postgresql_extension.pg_cron]
+}
+resource "postgresql_task" "backup_task" {
+	name = "backup_task"
+	query = "SELECT * FROM unnest(ARRAY[1]) AS element;"
+	schedule = "0 1 * * *"
+	vendor_type = "BACKUP"
+	vendor_id = "orders"
+	depends_on = [postgresql_extension.pg_cron]
+}
+data "postgresql_tasks" "retention" {
+	vendor_type = "RETENTION"
+	depends_on = [postgresql_task.retention_task, postgresql_task.backup_task]
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testCheckCompatibleVersion(t, featureTask)
+			testSuperuserPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.postgresql_tasks.retention", "tasks.#", "1"),
+					resource.TestCheckResourceAttr(
+						"data.postgresql_tasks.retention", "tasks.0.name", "retention_task"),
+					resource.TestCheckResourceAttr(
+						"data.postgresql_tasks.retention", "tasks.0.vendor_id", "orders"),
+				),
+			},
+		},
+	})
+}
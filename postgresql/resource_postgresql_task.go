@@ -2,23 +2,57 @@ package postgresql
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"log"
+	"regexp"
 	"strings"
 
+	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/lib/pq"
+	"github.com/robfig/cron/v3"
 )
 
 const (
-	taskNameAttr     = "name"
-	taskDatabaseAttr = "database"
-	taskSchemaAttr   = "schema"
-	taskScheduleAttr = "schedule"
-	taskQueryAttr    = "query"
+	taskNameAttr          = "name"
+	taskDatabaseAttr      = "database"
+	taskSchemaAttr        = "schema"
+	taskScheduleAttr      = "schedule"
+	taskQueryAttr         = "query"
+	taskEnabledAttr       = "enabled"
+	taskRunAsRoleAttr     = "run_as_role"
+	taskVendorTypeAttr    = "vendor_type"
+	taskVendorIDAttr      = "vendor_id"
+	taskCallbackParamAttr = "callback_param"
+
+	// featureTaskInterval gates pg_cron's non-cron interval schedules
+	// (e.g. "30 seconds"), which were introduced in pg_cron 1.5. Only second
+	// intervals are accepted; minute, hour and day intervals are rejected.
+	featureTaskInterval = "1.5.0"
+
+	// featureTaskScheduleInDatabase gates cron.schedule_in_database, which lets
+	// a task be scheduled against its target database (and, optionally, its
+	// run_as_role/enabled attributes) in a single atomic call.
+	featureTaskScheduleInDatabase = "1.4.0"
+
+	// taskMetaTable stores attributes pg_cron has no column for (vendor_type,
+	// vendor_id, callback_param), keyed by the same jobname used by cron.job.
+	// It is created lazily the first time a task uses one of these attributes.
+	taskMetaTable = "terraform_postgresql_task_meta"
 )
 
+// taskIntervalScheduleRe matches pg_cron's sub-minute interval grammar, e.g.
+// "30 seconds", as opposed to a standard five-field cron expression. pg_cron
+// only supports second-granularity intervals, and only for 1-59 seconds;
+// minute/hour/day repetition is expressed with the five-field form instead
+// (e.g. "*/5 * * * *" for every 5 minutes).
+var taskIntervalScheduleRe = regexp.MustCompile(`^([1-9]|[1-5][0-9]) seconds?$`)
+
+var taskCronParser = cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
+
 func resourcePostgreSQLTask() *schema.Resource {
 	return &schema.Resource{
 		Create: PGResourceFunc(resourcePostgreSQLTaskCreate),
@@ -29,6 +63,7 @@ func resourcePostgreSQLTask() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: validateTaskScheduleFeatureSupport,
 
 		Schema: map[string]*schema.Schema{
 			taskDatabaseAttr: {
@@ -61,10 +96,40 @@ func resourcePostgreSQLTask() *schema.Resource {
 				Description: "The query run by the task.",
 			},
 			taskScheduleAttr: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The cron schedule on which to run the task. Accepts a standard five-field cron expression, or (with pg_cron >= 1.5) a sub-minute interval of 1-59 seconds, e.g. \"30 seconds\".",
+				ValidateFunc: validateTaskSchedule,
+			},
+			taskEnabledAttr: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the task is active. Set to false to pause a scheduled task without losing its run history.",
+			},
+			taskRunAsRoleAttr: {
 				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "The cron schedule on which to run the task.",
+				Optional:    true,
+				Description: "The role under which the task's command is executed. Defaults to the role used by the provider's connection.",
+			},
+			taskVendorTypeAttr: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "An arbitrary short tag identifying the kind of system that owns this task (e.g. \"RETENTION\", \"BACKUP\"), queryable via the postgresql_tasks data source.",
+			},
+			taskVendorIDAttr: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "An arbitrary id, scoped by vendor_type, identifying the system that owns this task.",
+			},
+			taskCallbackParamAttr: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "An arbitrary JSON document the owning system can use to reconcile this task, e.g. parameters to pass to a callback.",
+
+				ValidateFunc:     validateTaskCallbackParam,
+				DiffSuppressFunc: diffSuppressEquivalentJSON,
 			},
 		},
 	}
@@ -104,8 +169,24 @@ func resourcePostgreSQLTaskUpdate(db *DBConnection, d *schema.ResourceData) erro
 		return err
 	}
 
-	if err := createTask(db, d); err != nil {
-		return err
+	// enabled and run_as_role can be applied in place via cron.alter_job so that
+	// pausing/resuming a task (or changing the role it runs as) does not reset
+	// its run history. A query change still goes through drop+recreate, and
+	// vendor attribute changes only touch taskMetaTable.
+	if d.HasChange(taskQueryAttr) {
+		if err := createTask(db, d); err != nil {
+			return err
+		}
+	} else if d.HasChange(taskEnabledAttr) || d.HasChange(taskRunAsRoleAttr) {
+		if err := alterTask(db, d); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange(taskVendorTypeAttr) || d.HasChange(taskVendorIDAttr) || d.HasChange(taskCallbackParamAttr) {
+		if err := setTaskMeta(db, d); err != nil {
+			return err
+		}
 	}
 
 	if err := resourcePostgreSQLTaskReadImpl(db, d); err != nil {
@@ -144,6 +225,10 @@ func resourcePostgreSQLTaskDelete(db *DBConnection, d *schema.ResourceData) erro
 		return err
 	}
 
+	if err := cleanupTaskMeta(txn, db, d); err != nil {
+		return err
+	}
+
 	if err := txn.Commit(); err != nil {
 		return err
 	}
@@ -153,6 +238,38 @@ func resourcePostgreSQLTaskDelete(db *DBConnection, d *schema.ResourceData) erro
 	return nil
 }
 
+// taskMetaTableExists reports whether taskMetaTable has been created yet, so
+// callers that only ever read it (Read, the postgresql_tasks data source)
+// don't force its creation, and don't require CREATE TABLE privilege, for
+// users who never set a vendor attribute.
+func taskMetaTableExists(txn *sql.Tx) (bool, error) {
+	var exists bool
+	if err := txn.QueryRow("SELECT to_regclass($1) IS NOT NULL", taskMetaTable).Scan(&exists); err != nil {
+		return false, err
+	}
+	return exists, nil
+}
+
+// cleanupTaskMeta removes the task's row from taskMetaTable, if any. The
+// table may not exist yet if no task has ever set a vendor attribute.
+func cleanupTaskMeta(txn *sql.Tx, db *DBConnection, d *schema.ResourceData) error {
+	tableExists, err := taskMetaTableExists(txn)
+	if err != nil {
+		return err
+	}
+	if !tableExists {
+		return nil
+	}
+
+	taskID, err := genTaskID(db, d)
+	if err != nil {
+		return err
+	}
+
+	_, err = txn.Exec(fmt.Sprintf("DELETE FROM %s WHERE jobname = $1", taskMetaTable), taskID)
+	return err
+}
+
 func resourcePostgreSQLTaskExists(db *DBConnection, d *schema.ResourceData) (bool, error) {
 	if err := runChecks(db); err != nil {
 		return false, err
@@ -186,18 +303,28 @@ func resourcePostgreSQLTaskExists(db *DBConnection, d *schema.ResourceData) (boo
 }
 
 type PGTask struct {
-	Database string
-	Schema   string
-	Name     string
-	Query    string
-	Schedule string
+	Database      string
+	Schema        string
+	Name          string
+	Query         string
+	Schedule      string
+	Enabled       bool
+	RunAsRole     string
+	VendorType    string
+	VendorID      string
+	CallbackParam string
 }
 
 type TaskInfo struct {
-	Database string `db:"database"`
-	Name     string `db:"name"`
-	Query    string `db:"query"`
-	Schedule string `db:"schedule"`
+	Database      string `db:"database"`
+	Name          string `db:"name"`
+	Query         string `db:"query"`
+	Schedule      string `db:"schedule"`
+	Enabled       bool   `db:"enabled"`
+	RunAsRole     string `db:"run_as_role"`
+	VendorType    string `db:"vendor_type"`
+	VendorID      string `db:"vendor_id"`
+	CallbackParam string `db:"callback_param"`
 }
 
 func resourcePostgreSQLTaskReadImpl(db *DBConnection, d *schema.ResourceData) error {
@@ -210,20 +337,44 @@ func resourcePostgreSQLTaskReadImpl(db *DBConnection, d *schema.ResourceData) er
 		taskID = genTaskID
 	}
 
-	query := `SELECT j.database AS database, ` +
-		`j.jobname AS name, ` +
-		`j.command AS query, ` +
-		`j.schedule AS schedule ` +
-		`FROM cron.job j ` +
-		`WHERE jobname = $1`
 	txn, err := startTransaction(db.client, "")
 	if err != nil {
 		return err
 	}
 	defer deferredRollback(txn)
 
+	metaTableExists, err := taskMetaTableExists(txn)
+	if err != nil {
+		return err
+	}
+
+	query := `SELECT j.database AS database, ` +
+		`j.jobname AS name, ` +
+		`j.command AS query, ` +
+		`j.schedule AS schedule, ` +
+		`j.active AS enabled, ` +
+		// A job running as the connection's own role is read back as an
+		// unset run_as_role, unless run_as_role names that role explicitly.
+		`CASE WHEN j.username = current_user AND $2::text = '' THEN '' ELSE j.username END AS run_as_role `
+	if metaTableExists {
+		query += `, COALESCE(m.vendor_type, '') AS vendor_type, ` +
+			`COALESCE(m.vendor_id, '') AS vendor_id, ` +
+			`COALESCE(m.callback_param::text, '') AS callback_param ` +
+			`FROM cron.job j ` +
+			`LEFT JOIN ` + taskMetaTable + ` m ON m.jobname = j.jobname `
+	} else {
+		query += `FROM cron.job j `
+	}
+	query += `WHERE j.jobname = $1`
+
 	var taskInfo TaskInfo
-	err = txn.QueryRow(query, taskID).Scan(&taskInfo.Database, &taskInfo.Name, &taskInfo.Query, &taskInfo.Schedule)
+	scanArgs := []interface{}{
+		&taskInfo.Database, &taskInfo.Name, &taskInfo.Query, &taskInfo.Schedule, &taskInfo.Enabled, &taskInfo.RunAsRole,
+	}
+	if metaTableExists {
+		scanArgs = append(scanArgs, &taskInfo.VendorType, &taskInfo.VendorID, &taskInfo.CallbackParam)
+	}
+	err = txn.QueryRow(query, taskID, d.Get(taskRunAsRoleAttr).(string)).Scan(scanArgs...)
 	switch {
 	case err == sql.ErrNoRows:
 		log.Printf("[WARN] PostgreSQL task: %s", taskID)
@@ -247,6 +398,11 @@ func resourcePostgreSQLTaskReadImpl(db *DBConnection, d *schema.ResourceData) er
 	d.Set(taskNameAttr, pgTask.Name)
 	d.Set(taskQueryAttr, pgTask.Query)
 	d.Set(taskScheduleAttr, pgTask.Schedule)
+	d.Set(taskEnabledAttr, pgTask.Enabled)
+	d.Set(taskRunAsRoleAttr, pgTask.RunAsRole)
+	d.Set(taskVendorTypeAttr, pgTask.VendorType)
+	d.Set(taskVendorIDAttr, pgTask.VendorID)
+	d.Set(taskCallbackParamAttr, pgTask.CallbackParam)
 
 	d.SetId(taskID)
 
@@ -261,6 +417,11 @@ func parseTask(taskInfo TaskInfo) (PGTask, error) {
 	pgTask.Name = taskIDParts[2]
 	pgTask.Query = taskInfo.Query
 	pgTask.Schedule = taskInfo.Schedule
+	pgTask.Enabled = taskInfo.Enabled
+	pgTask.RunAsRole = taskInfo.RunAsRole
+	pgTask.VendorType = taskInfo.VendorType
+	pgTask.VendorID = taskInfo.VendorID
+	pgTask.CallbackParam = taskInfo.CallbackParam
 
 	return pgTask, nil
 }
@@ -315,11 +476,17 @@ func createTask(db *DBConnection, d *schema.ResourceData) error {
 	}
 	query := d.Get(taskQueryAttr).(string)
 	cronSchedule := d.Get(taskScheduleAttr).(string)
-
-	// Construct the task
-	b := bytes.NewBufferString("SELECT cron.schedule(")
-	fmt.Fprint(b, pq.QuoteLiteral(fullTaskName), ", ", pq.QuoteLiteral(cronSchedule), ", ", pq.QuoteLiteral(query), "); ")
-	fmt.Fprint(b, "UPDATE cron.job SET database = ", pq.QuoteLiteral(databaseName), " WHERE jobname = ", pq.QuoteLiteral(fullTaskName), " AND database != ", pq.QuoteLiteral(databaseName), ";")
+	enabled := d.Get(taskEnabledAttr).(bool)
+	runAsRole := d.Get(taskRunAsRoleAttr).(string)
+
+	// cron.schedule_in_database lets the task be scheduled against its target
+	// database (and, atomically, its run_as_role/enabled attributes) in a
+	// single call. Older pg_cron only has cron.schedule, which always targets
+	// the connection's own database, so it's followed by a two-step patch.
+	scheduleInDatabaseSupported, err := pgCronVersionAtLeast(db, featureTaskScheduleInDatabase)
+	if err != nil {
+		return err
+	}
 
 	// Drop task command
 	dropTaskSql, err := genDropTaskCommand(db, d)
@@ -327,7 +494,6 @@ func createTask(db *DBConnection, d *schema.ResourceData) error {
 		return err
 	}
 
-	createTaskSql := b.String()
 	txn, err := startTransaction(db.client, "")
 	if err != nil {
 		return err
@@ -345,17 +511,331 @@ func createTask(db *DBConnection, d *schema.ResourceData) error {
 		}
 	}
 
-	if _, err := txn.Exec(createTaskSql); err != nil {
+	var jobID int64
+	if scheduleInDatabaseSupported {
+		scheduleSql := genScheduleInDatabaseCommand(fullTaskName, cronSchedule, query, databaseName, runAsRole, enabled)
+		if err := txn.QueryRow(scheduleSql).Scan(&jobID); err != nil {
+			return err
+		}
+	} else {
+		b := bytes.NewBufferString("SELECT cron.schedule(")
+		fmt.Fprint(b, pq.QuoteLiteral(fullTaskName), ", ", pq.QuoteLiteral(cronSchedule), ", ", pq.QuoteLiteral(query), ")")
+		if err := txn.QueryRow(b.String()).Scan(&jobID); err != nil {
+			return err
+		}
+
+		if _, err := txn.Exec(fmt.Sprint("UPDATE cron.job SET database = ", pq.QuoteLiteral(databaseName), " WHERE jobid = ", jobID, " AND database != ", pq.QuoteLiteral(databaseName), ";")); err != nil {
+			return err
+		}
+
+		if _, err := txn.Exec(genAlterTaskCommand(jobID, enabled, runAsRole)); err != nil {
+			return err
+		}
+	}
+
+	vendorType := d.Get(taskVendorTypeAttr).(string)
+	vendorID := d.Get(taskVendorIDAttr).(string)
+	callbackParam := d.Get(taskCallbackParamAttr).(string)
+	if vendorType != "" || vendorID != "" || callbackParam != "" {
+		if err := ensureTaskMetaTable(txn); err != nil {
+			return err
+		}
+		if err := upsertTaskMeta(txn, fullTaskName, vendorType, vendorID, callbackParam); err != nil {
+			return err
+		}
+	}
+
+	if err := txn.Commit(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// genScheduleInDatabaseCommand builds a cron.schedule_in_database(...) call
+// that schedules the task against databaseName directly, instead of relying
+// on a follow-up UPDATE cron.job SET database = ... that could race another
+// scheduler tick.
+func genScheduleInDatabaseCommand(fullTaskName, cronSchedule, query, databaseName, runAsRole string, enabled bool) string {
+	b := bytes.NewBufferString("SELECT cron.schedule_in_database(")
+	fmt.Fprint(b, pq.QuoteLiteral(fullTaskName), ", ", pq.QuoteLiteral(cronSchedule), ", ", pq.QuoteLiteral(query), ", ", pq.QuoteLiteral(databaseName))
+	if runAsRole != "" {
+		fmt.Fprint(b, ", ", pq.QuoteLiteral(runAsRole))
+	} else {
+		b.WriteString(", NULL")
+	}
+	fmt.Fprint(b, ", ", enabled)
+	b.WriteString(")")
+	return b.String()
+}
+
+// alterTask applies the enabled and run_as_role attributes to an existing task
+// via cron.alter_job, without dropping and recreating the underlying cron.job
+// row. This lets a paused task be resumed without losing its run history.
+func alterTask(db *DBConnection, d *schema.ResourceData) error {
+	taskID, err := genTaskID(db, d)
+	if err != nil {
+		return err
+	}
+
+	enabled := d.Get(taskEnabledAttr).(bool)
+	runAsRole := d.Get(taskRunAsRoleAttr).(string)
+
+	txn, err := startTransaction(db.client, "")
+	if err != nil {
+		return err
+	}
+	defer deferredRollback(txn)
+
+	jobID, err := getTaskJobID(txn, taskID)
+	if err != nil {
+		return err
+	}
+
+	if _, err := txn.Exec(genAlterTaskCommand(jobID, enabled, runAsRole)); err != nil {
+		return err
+	}
+
+	return txn.Commit()
+}
+
+// ensureTaskMetaTable lazily creates the side table used to persist
+// vendor_type/vendor_id/callback_param, which pg_cron's cron.job has no
+// columns for.
+func ensureTaskMetaTable(txn *sql.Tx) error {
+	_, err := txn.Exec(fmt.Sprintf(
+		`CREATE TABLE IF NOT EXISTS %s (jobname text primary key, vendor_type text, vendor_id text, callback_param jsonb)`,
+		taskMetaTable,
+	))
+	return err
+}
+
+func upsertTaskMeta(txn *sql.Tx, jobname, vendorType, vendorID, callbackParam string) error {
+	var callbackParamArg interface{}
+	if callbackParam != "" {
+		callbackParamArg = callbackParam
+	}
+
+	query := fmt.Sprintf(
+		`INSERT INTO %s (jobname, vendor_type, vendor_id, callback_param) VALUES ($1, NULLIF($2, ''), NULLIF($3, ''), $4::jsonb) `+
+			`ON CONFLICT (jobname) DO UPDATE SET vendor_type = EXCLUDED.vendor_type, vendor_id = EXCLUDED.vendor_id, callback_param = EXCLUDED.callback_param`,
+		taskMetaTable,
+	)
+	_, err := txn.Exec(query, jobname, vendorType, vendorID, callbackParamArg)
+	return err
+}
+
+// setTaskMeta upserts vendor_type/vendor_id/callback_param without touching
+// cron.job, since none of those attributes are known to pg_cron.
+func setTaskMeta(db *DBConnection, d *schema.ResourceData) error {
+	taskID, err := genTaskID(db, d)
+	if err != nil {
+		return err
+	}
+
+	vendorType := d.Get(taskVendorTypeAttr).(string)
+	vendorID := d.Get(taskVendorIDAttr).(string)
+	callbackParam := d.Get(taskCallbackParamAttr).(string)
+
+	txn, err := startTransaction(db.client, "")
+	if err != nil {
+		return err
+	}
+	defer deferredRollback(txn)
+
+	if vendorType == "" && vendorID == "" && callbackParam == "" {
+		// Nothing to persist; avoid creating taskMetaTable just to delete from
+		// it. cleanupTaskMeta on resource delete still removes any stale row.
+		tableExists, err := taskMetaTableExists(txn)
+		if err != nil {
+			return err
+		}
+		if !tableExists {
+			return txn.Commit()
+		}
+		_, err = txn.Exec(fmt.Sprintf("DELETE FROM %s WHERE jobname = $1", taskMetaTable), taskID)
+		if err != nil {
+			return err
+		}
+		return txn.Commit()
+	}
+
+	if err := ensureTaskMetaTable(txn); err != nil {
 		return err
 	}
 
+	if err := upsertTaskMeta(txn, taskID, vendorType, vendorID, callbackParam); err != nil {
+		return err
+	}
+
+	return txn.Commit()
+}
+
+func validateTaskCallbackParam(v interface{}, k string) (ws []string, errors []error) {
+	param := v.(string)
+	if param == "" {
+		return nil, nil
+	}
+	if !json.Valid([]byte(param)) {
+		errors = append(errors, fmt.Errorf("%s: %q is not valid JSON", k, param))
+	}
+	return ws, errors
+}
+
+// diffSuppressEquivalentJSON compares old/new as parsed JSON rather than raw
+// text, since callback_param round-trips through jsonb and Postgres
+// canonicalizes whitespace (e.g. adds a space after ":") on the way out.
+func diffSuppressEquivalentJSON(k, old, new string, d *schema.ResourceData) bool {
+	if old == new {
+		return true
+	}
+	if old == "" || new == "" {
+		return false
+	}
+
+	var oldVal, newVal interface{}
+	if err := json.Unmarshal([]byte(old), &oldVal); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(new), &newVal); err != nil {
+		return false
+	}
+
+	oldNormalized, err := json.Marshal(oldVal)
+	if err != nil {
+		return false
+	}
+	newNormalized, err := json.Marshal(newVal)
+	if err != nil {
+		return false
+	}
+
+	return string(oldNormalized) == string(newNormalized)
+}
+
+func getTaskJobID(txn *sql.Tx, taskID string) (int64, error) {
+	var jobID int64
+	if err := txn.QueryRow("SELECT jobid FROM cron.job WHERE jobname = $1", taskID).Scan(&jobID); err != nil {
+		return 0, fmt.Errorf("error looking up jobid for task %s: %w", taskID, err)
+	}
+	return jobID, nil
+}
+
+// genAlterTaskCommand always passes an explicit username argument. cron.alter_job
+// treats an omitted (NULL) username as "leave the current value alone", not
+// "reset to default" — so clearing run_as_role back to "" must still pass
+// current_user explicitly, or the job would keep running under the old role
+// forever.
+func genAlterTaskCommand(jobID int64, enabled bool, runAsRole string) string {
+	b := bytes.NewBufferString("SELECT cron.alter_job(")
+	fmt.Fprint(b, jobID, ", active := ", enabled, ", username := ")
+	if runAsRole != "" {
+		b.WriteString(pq.QuoteLiteral(runAsRole))
+	} else {
+		b.WriteString("current_user")
+	}
+	b.WriteString(");")
+	return b.String()
+}
+
+// validateTaskSchedule accepts both a standard five-field cron expression and
+// pg_cron's sub-minute interval grammar (1-59 seconds, e.g. "30 seconds").
+func validateTaskSchedule(v interface{}, k string) (ws []string, errors []error) {
+	schedule := v.(string)
+
+	if taskIntervalScheduleRe.MatchString(schedule) {
+		return nil, nil
+	}
+
+	if _, err := taskCronParser.Parse(schedule); err != nil {
+		errors = append(errors, fmt.Errorf(
+			"%s: %q is not a valid five-field cron expression or pg_cron sub-minute interval (e.g. \"0 * * * *\" or \"30 seconds\"): %w",
+			k, schedule, err,
+		))
+	}
+
+	return ws, errors
+}
+
+// validateTaskScheduleFeatureSupport rejects interval schedules at plan time
+// when the connected pg_cron extension is too old to understand them,
+// instead of letting Postgres reject the cron.schedule() call at apply time.
+func validateTaskScheduleFeatureSupport(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	schedule := diff.Get(taskScheduleAttr).(string)
+	if !taskIntervalScheduleRe.MatchString(schedule) {
+		return nil
+	}
+
+	client := meta.(*Client)
+	db, err := client.Connect()
+	if err != nil {
+		return err
+	}
+
+	// pg_cron may be created later in the same apply (e.g. by a
+	// postgresql_extension resource), so its version cannot be checked yet;
+	// runChecks still rejects the task at apply time if it is missing.
+	var extensionExists bool
+	txn, err := startTransaction(client, "")
+	if err != nil {
+		return err
+	}
+	defer deferredRollback(txn)
+	if err := txn.QueryRow("SELECT count(*) > 0 FROM pg_extension WHERE extname = 'pg_cron'").Scan(&extensionExists); err != nil {
+		return err
+	}
 	if err := txn.Commit(); err != nil {
 		return err
 	}
+	if !extensionExists {
+		return nil
+	}
+
+	supported, err := pgCronVersionAtLeast(db, featureTaskInterval)
+	if err != nil {
+		return err
+	}
+	if !supported {
+		return fmt.Errorf(
+			"interval schedule %q requires pg_cron >= %s; upgrade the pg_cron extension or use a five-field cron expression",
+			schedule, featureTaskInterval,
+		)
+	}
 
 	return nil
 }
 
+// pgCronVersionAtLeast compares the installed pg_cron extension's version
+// (from pg_extension.extversion) against minVersion, rather than the Postgres
+// server version used by runChecks/featureSupported.
+func pgCronVersionAtLeast(db *DBConnection, minVersion string) (bool, error) {
+	txn, err := startTransaction(db.client, "")
+	if err != nil {
+		return false, err
+	}
+	defer deferredRollback(txn)
+
+	var extVersion string
+	if err := txn.QueryRow("SELECT extversion FROM pg_extension WHERE extname = 'pg_cron'").Scan(&extVersion); err != nil {
+		return false, fmt.Errorf("error reading pg_cron extension version: %w", err)
+	}
+
+	if err := txn.Commit(); err != nil {
+		return false, err
+	}
+
+	current, err := version.NewVersion(extVersion)
+	if err != nil {
+		return false, fmt.Errorf("error parsing pg_cron extension version %q: %w", extVersion, err)
+	}
+	min, err := version.NewVersion(minVersion)
+	if err != nil {
+		return false, err
+	}
+
+	return current.GreaterThanOrEqual(min), nil
+}
+
 func runChecks(db *DBConnection) error {
 	if !db.featureSupported(featureTask) {
 		return fmt.Errorf(
@@ -219,6 +219,327 @@ resource "postgresql_task" "basic_task" {
 	})
 }
 
+func TestValidateTaskSchedule(t *testing.T) {
+	validSchedules := []string{
+		"0 * * * *",
+		"*/5 * * * *",
+		"30 seconds",
+		"1 second",
+		"59 seconds",
+	}
+	for _, schedule := range validSchedules {
+		if _, errors := validateTaskSchedule(schedule, "schedule"); len(errors) > 0 {
+			t.Errorf("expected %q to be a valid schedule, got errors: %v", schedule, errors)
+		}
+	}
+
+	invalidSchedules := []string{
+		"not a schedule",
+		"30 fortnights",
+		"* * * *",
+		"1 hour",
+		"5 minutes",
+		"2 days",
+		"0 seconds",
+		"60 seconds",
+	}
+	for _, schedule := range invalidSchedules {
+		if _, errors := validateTaskSchedule(schedule, "schedule"); len(errors) == 0 {
+			t.Errorf("expected %q to be an invalid schedule", schedule)
+		}
+	}
+}
+
+func TestValidateTaskCallbackParam(t *testing.T) {
+	if _, errors := validateTaskCallbackParam("", "callback_param"); len(errors) > 0 {
+		t.Errorf("expected empty callback_param to be valid, got errors: %v", errors)
+	}
+	if _, errors := validateTaskCallbackParam(`{"days": 30}`, "callback_param"); len(errors) > 0 {
+		t.Errorf("expected valid JSON to be accepted, got errors: %v", errors)
+	}
+	if _, errors := validateTaskCallbackParam("not json", "callback_param"); len(errors) == 0 {
+		t.Error("expected invalid JSON to be rejected")
+	}
+}
+
+func TestAccPostgresqlTask_IntervalSchedule(t *testing.T) {
+	skipIfNotAcc(t)
+
+	config := `
+resource "postgresql_extension" "pg_cron" {
+	name = "pg_cron"
+}
+resource "postgresql_task" "basic_task" {
+	name = "basic_task"
+	query = "SELECT * FROM unnest(ARRAY[1]) AS element;"
+	schedule = "30 seconds"
+	depends_on = [postgresql_extension.pg_cron]
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testCheckCompatibleVersion(t, featureTask)
+			testSuperuserPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPostgresqlTaskDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPostgresqlTaskExists("postgresql_task.basic_task", ""),
+					resource.TestCheckResourceAttr(
+						"postgresql_task.basic_task", "schedule", "30 seconds"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPostgresqlTask_VendorTagging(t *testing.T) {
+	skipIfNotAcc(t)
+
+	config := `
+resource "postgresql_extension" "pg_cron" {
+	name = "pg_cron"
+}
+resource "postgresql_task" "basic_task" {
+	name = "basic_task"
+	query = "SELECT * FROM unnest(ARRAY[1]) AS element;"
+	schedule = "0 * * * *"
+	vendor_type = "RETENTION"
+	vendor_id = "orders"
+	callback_param = jsonencode({ days = 30 })
+	depends_on = [postgresql_extension.pg_cron]
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testCheckCompatibleVersion(t, featureTask)
+			testSuperuserPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPostgresqlTaskDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPostgresqlTaskExists("postgresql_task.basic_task", ""),
+					resource.TestCheckResourceAttr(
+						"postgresql_task.basic_task", "vendor_type", "RETENTION"),
+					resource.TestCheckResourceAttr(
+						"postgresql_task.basic_task", "vendor_id", "orders"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPostgresqlTask_ToggleEnabled(t *testing.T) {
+	skipIfNotAcc(t)
+
+	configEnabled := `
+resource "postgresql_extension" "pg_cron" {
+	name = "pg_cron"
+}
+resource "postgresql_task" "basic_task" {
+	name = "basic_task"
+	query = "SELECT * FROM unnest(ARRAY[1]) AS element;"
+	schedule = "0 * * * *"
+	enabled = true
+	depends_on = [postgresql_extension.pg_cron]
+}
+`
+
+	configDisabled := `
+resource "postgresql_extension" "pg_cron" {
+	name = "pg_cron"
+}
+resource "postgresql_task" "basic_task" {
+	name = "basic_task"
+	query = "SELECT * FROM unnest(ARRAY[1]) AS element;"
+	schedule = "0 * * * *"
+	enabled = false
+	depends_on = [postgresql_extension.pg_cron]
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testCheckCompatibleVersion(t, featureTask)
+			testSuperuserPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPostgresqlTaskDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: configEnabled,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPostgresqlTaskExists("postgresql_task.basic_task", ""),
+					resource.TestCheckResourceAttr(
+						"postgresql_task.basic_task", "enabled", "true"),
+					testAccCheckPostgresqlTaskActive("postgresql_task.basic_task", true),
+				),
+			},
+			{
+				Config: configDisabled,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPostgresqlTaskExists("postgresql_task.basic_task", ""),
+					resource.TestCheckResourceAttr(
+						"postgresql_task.basic_task", "enabled", "false"),
+					testAccCheckPostgresqlTaskActive("postgresql_task.basic_task", false),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPostgresqlTask_RunAsRole(t *testing.T) {
+	skipIfNotAcc(t)
+
+	config := `
+resource "postgresql_extension" "pg_cron" {
+	name = "pg_cron"
+}
+resource "postgresql_role" "task_role" {
+	name = "task_role"
+	login = true
+}
+resource "postgresql_task" "basic_task" {
+	name = "basic_task"
+	query = "SELECT * FROM unnest(ARRAY[1]) AS element;"
+	schedule = "0 * * * *"
+	run_as_role = postgresql_role.task_role.name
+	depends_on = [postgresql_extension.pg_cron]
+}
+`
+
+	configCleared := `
+resource "postgresql_extension" "pg_cron" {
+	name = "pg_cron"
+}
+resource "postgresql_role" "task_role" {
+	name = "task_role"
+	login = true
+}
+resource "postgresql_task" "basic_task" {
+	name = "basic_task"
+	query = "SELECT * FROM unnest(ARRAY[1]) AS element;"
+	schedule = "0 * * * *"
+	depends_on = [postgresql_extension.pg_cron]
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testCheckCompatibleVersion(t, featureTask)
+			testSuperuserPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPostgresqlTaskDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPostgresqlTaskExists("postgresql_task.basic_task", ""),
+					resource.TestCheckResourceAttr(
+						"postgresql_task.basic_task", "run_as_role", "task_role"),
+					testAccCheckPostgresqlTaskRunAsRole("postgresql_task.basic_task", "task_role"),
+				),
+			},
+			{
+				// Clearing run_as_role must reset cron.job.username back to the
+				// provider's connection role, not leave it at task_role forever.
+				Config: configCleared,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPostgresqlTaskExists("postgresql_task.basic_task", ""),
+					resource.TestCheckResourceAttr(
+						"postgresql_task.basic_task", "run_as_role", ""),
+					testAccCheckPostgresqlTaskRunAsRole("postgresql_task.basic_task", ""),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckPostgresqlTaskRunAsRole asserts cron.job.username for the given
+// task. An empty expectedRole means the job should be running as whatever
+// role the provider itself connects as (current_user), i.e. the pg_cron
+// default rather than a leftover value from a previously-set run_as_role.
+func testAccCheckPostgresqlTaskRunAsRole(n, expectedRole string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Resource not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		client := testAccProvider.Meta().(*Client)
+		txn, err := startTransaction(client, "")
+		if err != nil {
+			return err
+		}
+		defer deferredRollback(txn)
+
+		if expectedRole == "" {
+			if err := txn.QueryRow("SELECT current_user").Scan(&expectedRole); err != nil {
+				return fmt.Errorf("Error reading current_user: %s", err)
+			}
+		}
+
+		var gotRole string
+		if err := txn.QueryRow("SELECT username FROM cron.job WHERE jobname = $1", rs.Primary.ID).Scan(&gotRole); err != nil {
+			return fmt.Errorf("Error reading username column for task %s: %s", rs.Primary.ID, err)
+		}
+
+		if gotRole != expectedRole {
+			return fmt.Errorf("Expected cron.job.username to be %q, got %q", expectedRole, gotRole)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPostgresqlTaskActive(n string, active bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Resource not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		client := testAccProvider.Meta().(*Client)
+		txn, err := startTransaction(client, "")
+		if err != nil {
+			return err
+		}
+		defer deferredRollback(txn)
+
+		var gotActive bool
+		if err := txn.QueryRow("SELECT active FROM cron.job WHERE jobname = $1", rs.Primary.ID).Scan(&gotActive); err != nil {
+			return fmt.Errorf("Error reading active column for task %s: %s", rs.Primary.ID, err)
+		}
+
+		if gotActive != active {
+			return fmt.Errorf("Expected active to be %t, got %t", active, gotActive)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckPostgresqlTaskDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*Client)
 
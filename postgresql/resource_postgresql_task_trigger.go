@@ -0,0 +1,127 @@
+package postgresql
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	taskTriggerTaskIDAttr     = "task_id"
+	taskTriggerTriggersAttr   = "triggers"
+	taskTriggerBackendPIDAttr = "backend_pid"
+	taskTriggerStatusAttr     = "status"
+)
+
+// resourcePostgreSQLTaskTrigger runs a postgresql_task's command immediately,
+// outside its cron schedule. It has no real-world counterpart to read back:
+// like null_resource, it only exists to react to changes in `triggers`.
+func resourcePostgreSQLTaskTrigger() *schema.Resource {
+	return &schema.Resource{
+		Create: PGResourceFunc(resourcePostgreSQLTaskTriggerCreate),
+		Read:   PGResourceFunc(resourcePostgreSQLTaskTriggerRead),
+		Delete: PGResourceFunc(resourcePostgreSQLTaskTriggerDelete),
+
+		Schema: map[string]*schema.Schema{
+			taskTriggerTaskIDAttr: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The id of the postgresql_task resource to trigger.",
+			},
+			taskTriggerTriggersAttr: {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "A map of arbitrary strings that, when changed, causes the referenced task's command to be executed immediately. Same semantics as null_resource's triggers.",
+			},
+			taskTriggerBackendPIDAttr: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The backend PID that executed the triggered command. pg_cron does not log manually triggered runs in cron.job_run_details, so this is not a postgresql_task_run_details runid, just an identifier for the invocation.",
+			},
+			taskTriggerStatusAttr: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the triggered run, \"succeeded\" unless the command errored, in which case apply fails before this is ever recorded.",
+			},
+		},
+	}
+}
+
+func resourcePostgreSQLTaskTriggerCreate(db *DBConnection, d *schema.ResourceData) error {
+	if err := runChecks(db); err != nil {
+		return err
+	}
+
+	taskID := d.Get(taskTriggerTaskIDAttr).(string)
+
+	runPID, err := triggerTask(db, taskID)
+	if err != nil {
+		return err
+	}
+
+	d.Set(taskTriggerBackendPIDAttr, runPID)
+	d.Set(taskTriggerStatusAttr, "succeeded")
+	d.SetId(fmt.Sprintf("%s/%d", taskID, time.Now().UnixNano()))
+
+	return nil
+}
+
+// triggerTask looks up the task's command and target database from cron.job
+// and executes the command directly against that database, returning the
+// backend PID of the connection that ran it.
+func triggerTask(db *DBConnection, taskID string) (int, error) {
+	lookupTxn, err := startTransaction(db.client, "")
+	if err != nil {
+		return 0, err
+	}
+	defer deferredRollback(lookupTxn)
+
+	var command, database string
+	err = lookupTxn.QueryRow("SELECT command, database FROM cron.job WHERE jobname = $1", taskID).Scan(&command, &database)
+	switch {
+	case err == sql.ErrNoRows:
+		return 0, fmt.Errorf("no postgresql_task with id %q was found", taskID)
+	case err != nil:
+		return 0, fmt.Errorf("error looking up task %q: %w", taskID, err)
+	}
+
+	if err := lookupTxn.Commit(); err != nil {
+		return 0, err
+	}
+
+	txn, err := startTransaction(db.client, database)
+	if err != nil {
+		return 0, err
+	}
+	defer deferredRollback(txn)
+
+	var runPID int
+	if err := txn.QueryRow("SELECT pg_backend_pid()").Scan(&runPID); err != nil {
+		return 0, err
+	}
+
+	if _, err := txn.Exec(command); err != nil {
+		return 0, fmt.Errorf("error triggering task %q: %w", taskID, err)
+	}
+
+	if err := txn.Commit(); err != nil {
+		return 0, err
+	}
+
+	return runPID, nil
+}
+
+func resourcePostgreSQLTaskTriggerRead(db *DBConnection, d *schema.ResourceData) error {
+	// The trigger represents a point-in-time event, not persistent state:
+	// there is nothing in Postgres to read back.
+	return nil
+}
+
+func resourcePostgreSQLTaskTriggerDelete(db *DBConnection, d *schema.ResourceData) error {
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,108 @@
+package postgresql
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccPostgresqlTaskTrigger_Basic(t *testing.T) {
+	skipIfNotAcc(t)
+
+	configCreate := `
+resource "postgresql_extension" "pg_cron" {
+	name = "pg_cron"
+}
+resource "postgresql_task" "basic_task" {
+	name = "basic_task"
+	query = "CREATE TABLE IF NOT EXISTS trigger_marker(n int); INSERT INTO trigger_marker(n) VALUES (1);"
+	schedule = "0 0 1 1 *"
+	depends_on = [postgresql_extension.pg_cron]
+}
+resource "postgresql_task_trigger" "basic_trigger" {
+	task_id = postgresql_task.basic_task.id
+	triggers = {
+		run = "1"
+	}
+}
+`
+
+	configRetrigger := `
+resource "postgresql_extension" "pg_cron" {
+	name = "pg_cron"
+}
+resource "postgresql_task" "basic_task" {
+	name = "basic_task"
+	query = "CREATE TABLE IF NOT EXISTS trigger_marker(n int); INSERT INTO trigger_marker(n) VALUES (1);"
+	schedule = "0 0 1 1 *"
+	depends_on = [postgresql_extension.pg_cron]
+}
+resource "postgresql_task_trigger" "basic_trigger" {
+	task_id = postgresql_task.basic_task.id
+	triggers = {
+		run = "2"
+	}
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testCheckCompatibleVersion(t, featureTask)
+			testSuperuserPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: configCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"postgresql_task_trigger.basic_trigger", "status", "succeeded"),
+					resource.TestCheckResourceAttrSet(
+						"postgresql_task_trigger.basic_trigger", "backend_pid"),
+					testAccCheckPostgresqlTriggerMarkerCount(1),
+				),
+			},
+			{
+				// The triggers map is ForceNew, so this forces the trigger
+				// resource to be recreated, which re-executes the task's
+				// command and inserts a second row.
+				Config: configRetrigger,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"postgresql_task_trigger.basic_trigger", "status", "succeeded"),
+					resource.TestCheckResourceAttrSet(
+						"postgresql_task_trigger.basic_trigger", "backend_pid"),
+					testAccCheckPostgresqlTriggerMarkerCount(2),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckPostgresqlTriggerMarkerCount verifies that the triggered task's
+// command actually ran, rather than just that postgresql_task_trigger's
+// Create didn't error.
+func testAccCheckPostgresqlTriggerMarkerCount(expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*Client)
+		txn, err := startTransaction(client, "")
+		if err != nil {
+			return err
+		}
+		defer deferredRollback(txn)
+
+		var got int
+		if err := txn.QueryRow("SELECT count(*) FROM trigger_marker").Scan(&got); err != nil {
+			return fmt.Errorf("Error counting trigger_marker rows: %s", err)
+		}
+
+		if got != expected {
+			return fmt.Errorf("Expected %d trigger_marker rows, got %d", expected, got)
+		}
+
+		return nil
+	}
+}